@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// knownCategoryOrder is the preferred display order for categories that
+// the static table and the tmux-derived categorizer both produce.
+var knownCategoryOrder = []string{
+	"Sessions", "Windows", "Pane Splitting & Nav", "Pane Management", "Copy Mode", "Misc", "Root",
+}
+
+// orderedCategoryList returns the distinct categories present in
+// shortcuts, in knownCategoryOrder followed by any unrecognized
+// categories in first-seen order.
+func orderedCategoryList(shortcuts []Shortcut) []string {
+	seen := make(map[string]bool)
+	var order []string
+
+	for _, k := range knownCategoryOrder {
+		for _, s := range shortcuts {
+			if s.Category == k {
+				order = append(order, k)
+				seen[k] = true
+				break
+			}
+		}
+	}
+	for _, s := range shortcuts {
+		if !seen[s.Category] {
+			seen[s.Category] = true
+			order = append(order, s.Category)
+		}
+	}
+	return order
+}
+
+// renderJSON emits shortcuts as a JSON array for tooling consumers.
+func renderJSON(shortcuts []Shortcut) (string, error) {
+	data, err := json.MarshalIndent(shortcuts, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal shortcuts: %w", err)
+	}
+	return string(data), nil
+}
+
+// renderMarkdown renders shortcuts as a category-headed Markdown document
+// with a "| Key | Description |" table per category.
+func renderMarkdown(shortcuts []Shortcut) string {
+	byCategory := make(map[string][]Shortcut)
+	for _, s := range shortcuts {
+		byCategory[s.Category] = append(byCategory[s.Category], s)
+	}
+
+	var b strings.Builder
+	b.WriteString("# tmux Shortcuts\n")
+	for _, cat := range orderedCategoryList(shortcuts) {
+		fmt.Fprintf(&b, "\n## %s\n\n", cat)
+		b.WriteString("| Key | Description |\n")
+		b.WriteString("| --- | --- |\n")
+		for _, s := range byCategory[cat] {
+			fmt.Fprintf(&b, "| %s | %s |\n", escapeMarkdownCell(s.Key), escapeMarkdownCell(s.Description))
+		}
+	}
+	return b.String()
+}
+
+// escapeMarkdownCell escapes characters that would otherwise break a
+// Markdown table cell. Dynamic-mode descriptions are raw tmux commands
+// (e.g. `if-shell '... | ...'`) and can contain "|" or "`" verbatim.
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "|", "\\|")
+	s = strings.ReplaceAll(s, "`", "\\`")
+	return s
+}
+
+// renderMan renders shortcuts as a groff-formatted man page suitable for
+// piping to `man -l -`.
+func renderMan(shortcuts []Shortcut) string {
+	byCategory := make(map[string][]Shortcut)
+	for _, s := range shortcuts {
+		byCategory[s.Category] = append(byCategory[s.Category], s)
+	}
+
+	var b strings.Builder
+	b.WriteString(".TH TMUX-SHORTCUTS 1 \"\" \"tmux-shortcuts\" \"User Commands\"\n")
+	b.WriteString(".SH NAME\n")
+	b.WriteString("tmux-shortcuts \\- list tmux key bindings\n")
+	b.WriteString(".SH SHORTCUTS\n")
+	for _, cat := range orderedCategoryList(shortcuts) {
+		fmt.Fprintf(&b, ".SS %s\n", manEscape(cat))
+		for _, s := range byCategory[cat] {
+			b.WriteString(".TP\n")
+			fmt.Fprintf(&b, ".B %s\n", manEscape(s.Key))
+			fmt.Fprintf(&b, "%s\n", manEscape(s.Description))
+		}
+	}
+	return b.String()
+}
+
+// manEscape escapes characters groff treats specially when they appear
+// at the start of a line or as macro arguments.
+func manEscape(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+		s = "\\&" + s
+	}
+	return s
+}
+
+// renderCompletion returns a shell completion snippet for the given
+// shell ("bash", "zsh", or "fish") that completes this tool's flags.
+func renderCompletion(shell string) (string, error) {
+	switch shell {
+	case "bash":
+		return bashCompletion, nil
+	case "zsh":
+		return zshCompletion, nil
+	case "fish":
+		return fishCompletion, nil
+	default:
+		return "", fmt.Errorf("unsupported completion shell %q (want bash, zsh, or fish)", shell)
+	}
+}
+
+const bashCompletion = `_tmux_shortcuts() {
+  local cur="${COMP_WORDS[COMP_CWORD]}"
+  COMPREPLY=($(compgen -W "-i --send --static --format --watch --theme --theme-file --border" -- "$cur"))
+}
+complete -F _tmux_shortcuts tmux-shortcuts
+`
+
+const zshCompletion = `#compdef tmux-shortcuts
+_arguments \
+  '-i[launch interactive fuzzy-search TUI]' \
+  '--send[dispatch selected shortcut via tmux send-keys]' \
+  '--static[use the built-in shortcut table]' \
+  '--format[output format]:format:(columns json markdown man fish bash zsh)' \
+  '--watch[re-render on terminal resize]' \
+  '--theme[color theme]:theme:(dark light mono)' \
+  '--theme-file[path to a custom theme JSON file]:file:_files' \
+  '--border[draw a box around each column]'
+`
+
+const fishCompletion = `complete -c tmux-shortcuts -s i -d 'launch interactive fuzzy-search TUI'
+complete -c tmux-shortcuts -l send -d 'dispatch selected shortcut via tmux send-keys'
+complete -c tmux-shortcuts -l static -d 'use the built-in shortcut table'
+complete -c tmux-shortcuts -l format -x -a 'columns json markdown man fish bash zsh' -d 'output format'
+complete -c tmux-shortcuts -l watch -d 're-render on terminal resize'
+complete -c tmux-shortcuts -l theme -x -a 'dark light mono' -d 'color theme'
+complete -c tmux-shortcuts -l theme-file -r -d 'path to a custom theme JSON file'
+complete -c tmux-shortcuts -l border -d 'draw a box around each column'
+`
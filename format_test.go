@@ -0,0 +1,18 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdownEscapesTableBreakingChars(t *testing.T) {
+	shortcuts := []Shortcut{
+		{Key: "Prefix x", Description: "if-shell 'test | grep foo' `run`", Category: "Misc"},
+	}
+
+	out := renderMarkdown(shortcuts)
+	want := "| Prefix x | if-shell 'test \\| grep foo' \\`run\\` |\n"
+	if !strings.Contains(out, want) {
+		t.Errorf("renderMarkdown output %q does not contain escaped row %q", out, want)
+	}
+}
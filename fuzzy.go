@@ -0,0 +1,52 @@
+package main
+
+import "unicode"
+
+// fuzzyMatch reports whether pattern occurs as a subsequence of text
+// (case-insensitively) and, if so, returns a score where higher means
+// a better match. Scoring rewards contiguous runs, matches at the start
+// of a word, and matches that land on an upper-case letter in camelCase
+// text, similar to the heuristics used by fzf.
+func fuzzyMatch(pattern, text string) (score int, ok bool) {
+	if pattern == "" {
+		return 0, true
+	}
+
+	p := []rune(toLower(pattern))
+	t := []rune(text)
+	tl := []rune(toLower(text))
+
+	pi := 0
+	prevMatched := false
+	for ti := 0; ti < len(tl) && pi < len(p); ti++ {
+		if tl[ti] != p[pi] {
+			prevMatched = false
+			continue
+		}
+
+		score++
+
+		if prevMatched {
+			score += 5 // contiguous-match bonus
+		}
+		if ti == 0 || t[ti-1] == ' ' || t[ti-1] == '-' || t[ti-1] == '_' {
+			score += 10 // start-of-word bonus
+		}
+		if unicode.IsUpper(t[ti]) && ti > 0 && !unicode.IsUpper(t[ti-1]) {
+			score += 5 // camelCase bonus
+		}
+
+		prevMatched = true
+		pi++
+	}
+
+	return score, pi == len(p)
+}
+
+func toLower(s string) string {
+	r := []rune(s)
+	for i, c := range r {
+		r[i] = unicode.ToLower(c)
+	}
+	return string(r)
+}
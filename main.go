@@ -1,11 +1,17 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"os"
 	"os/exec"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
+
+	"github.com/mattn/go-runewidth"
 )
 
 // Shortcut represents a single tmux shortcut.
@@ -82,52 +88,115 @@ func getTerminalWidth() int {
 	return 80 // Default width
 }
 
-// center pads a string with spaces to center it within a given width.
+// center pads a string with spaces to center it within a given display
+// width, measuring width with go-runewidth so multi-byte runes (box-drawing
+// characters, accents, CJK, emoji) are counted by the terminal cells they
+// occupy rather than by byte count.
 func center(s string, width int) string {
-	if len(s) >= width {
+	w := runewidth.StringWidth(s)
+	if w >= width {
 		return s
 	}
-	padding := (width - len(s)) / 2
-	rightPadding := width - len(s) - padding
+	padding := (width - w) / 2
+	rightPadding := width - w - padding
 	return strings.Repeat(" ", padding) + s + strings.Repeat(" ", rightPadding)
 }
 
-// formatCell pads a string with spaces to fit in a cell of a given width.
+// formatCell pads a string with spaces to fit in a cell of a given display
+// width, measuring width with go-runewidth.
 func formatCell(s string, width int) string {
-	return fmt.Sprintf("%-*s", width, s)
+	w := runewidth.StringWidth(s)
+	if w >= width {
+		return s
+	}
+	return s + strings.Repeat(" ", width-w)
 }
 
-// wordWrap wraps a string to a given line width.
+// wordWrap wraps a string to a given display-width line width, measuring
+// runes with go-runewidth rather than counting bytes. A word wider than
+// lineWidth on its own is hard-broken at rune boundaries instead of
+// overflowing the line.
 func wordWrap(text string, lineWidth int) []string {
 	words := strings.Fields(strings.TrimSpace(text))
 	if len(words) == 0 {
 		return nil
 	}
+
 	var lines []string
 	var currentLine string
+	currentWidth := 0
+
 	for _, word := range words {
-		if len(currentLine)+len(word)+1 > lineWidth {
+		wordWidth := runewidth.StringWidth(word)
+
+		if wordWidth > lineWidth {
+			if currentLine != "" {
+				lines = append(lines, currentLine)
+			}
+			var brokenLines []string
+			brokenLines, currentLine, currentWidth = breakWord(word, lineWidth)
+			lines = append(lines, brokenLines...)
+			continue
+		}
+
+		spacing := 0
+		if currentLine != "" {
+			spacing = 1
+		}
+		if currentWidth+spacing+wordWidth > lineWidth {
 			lines = append(lines, currentLine)
-			currentLine = ""
+			currentLine, currentWidth = word, wordWidth
+			continue
 		}
+
 		if currentLine == "" {
 			currentLine = word
 		} else {
 			currentLine += " " + word
 		}
+		currentWidth += spacing + wordWidth
 	}
 	lines = append(lines, currentLine)
 	return lines
 }
 
-// displayShortcutsInColumns categorizes and prints shortcuts in a dynamic column layout.
-func displayShortcutsInColumns(allShortcuts []Shortcut) {
+// breakWord hard-breaks word into lines no wider than lineWidth. It
+// returns every full line produced along with the trailing partial line
+// and its display width, so the caller can keep filling that line with
+// subsequent words.
+func breakWord(word string, lineWidth int) (lines []string, tail string, tailWidth int) {
+	var b strings.Builder
+	width := 0
+	for _, r := range word {
+		rw := runewidth.RuneWidth(r)
+		if width+rw > lineWidth && b.Len() > 0 {
+			lines = append(lines, b.String())
+			b.Reset()
+			width = 0
+		}
+		b.WriteRune(r)
+		width += rw
+	}
+	return lines, b.String(), width
+}
+
+// styledLine is a single line of column output tagged with the theme
+// field it should be colorized with ("" for lines that aren't styled).
+type styledLine struct {
+	text string
+	kind string
+}
+
+// displayShortcutsInColumns categorizes and prints shortcuts in a dynamic
+// column layout, styled with theme. When border is set, each column is
+// drawn inside a box using the theme's Border color.
+func displayShortcutsInColumns(allShortcuts []Shortcut, theme ColorTheme, border bool) {
 	categorized := make(map[string][]Shortcut)
 	for _, s := range allShortcuts {
 		categorized[s.Category] = append(categorized[s.Category], s)
 	}
 
-	orderedCategories := []string{"Sessions", "Windows", "Pane Splitting & Nav", "Pane Management", "Misc", "Copy Mode"}
+	orderedCategories := orderedCategoryList(allShortcuts)
 
 	columnWidth := 25
 	columnSpacing := 2
@@ -140,7 +209,7 @@ func displayShortcutsInColumns(allShortcuts []Shortcut) {
 
 	// Block represents an atomic unit with metadata
 	type block struct {
-		lines      []string
+		lines      []styledLine
 		isHeader   bool
 		categoryId int
 	}
@@ -150,23 +219,27 @@ func displayShortcutsInColumns(allShortcuts []Shortcut) {
 	var totalLines int
 	for i, catName := range orderedCategories {
 		// Category header block
-		var headerBlock []string
+		var headerBlock []styledLine
 		if i > 0 {
-			headerBlock = append(headerBlock, "")
+			headerBlock = append(headerBlock, styledLine{"", ""})
 		}
-		headerBlock = append(headerBlock, center(catName, columnWidth), strings.Repeat("â”€", columnWidth), "")
+		headerBlock = append(headerBlock,
+			styledLine{center(catName, columnWidth), "category"},
+			styledLine{strings.Repeat("â”€", columnWidth), "separator"},
+			styledLine{"", ""},
+		)
 		allBlocks = append(allBlocks, block{lines: headerBlock, isHeader: true, categoryId: i})
 		totalLines += len(headerBlock)
 
 		// Each shortcut is its own atomic block
 		for _, shortcut := range categorized[catName] {
-			var shortcutBlock []string
-			shortcutBlock = append(shortcutBlock, formatCell(" "+shortcut.Key, columnWidth))
+			var shortcutBlock []styledLine
+			shortcutBlock = append(shortcutBlock, styledLine{formatCell(" "+shortcut.Key, columnWidth), "key"})
 			descLines := wordWrap(shortcut.Description, columnWidth-2)
 			for _, line := range descLines {
-				shortcutBlock = append(shortcutBlock, formatCell("  "+line, columnWidth))
+				shortcutBlock = append(shortcutBlock, styledLine{formatCell("  "+line, columnWidth), "description"})
 			}
-			shortcutBlock = append(shortcutBlock, "") // Blank line after each entry
+			shortcutBlock = append(shortcutBlock, styledLine{"", ""}) // Blank line after each entry
 			allBlocks = append(allBlocks, block{lines: shortcutBlock, isHeader: false, categoryId: i})
 			totalLines += len(shortcutBlock)
 		}
@@ -176,7 +249,7 @@ func displayShortcutsInColumns(allShortcuts []Shortcut) {
 	}
 
 	// Distribute atomic blocks into columns
-	columns := make([][]string, numDisplayColumns)
+	columns := make([][]styledLine, numDisplayColumns)
 	columnHeight := (totalLines + numDisplayColumns - 1) / numDisplayColumns
 	currentCol := 0
 
@@ -197,7 +270,7 @@ func displayShortcutsInColumns(allShortcuts []Shortcut) {
 				break
 			}
 			// Skip leading empty lines when starting a new column
-			if len(blockLines) > 0 && strings.TrimSpace(blockLines[0]) == "" {
+			if len(blockLines) > 0 && strings.TrimSpace(blockLines[0].text) == "" {
 				blockLines = blockLines[1:]
 			}
 		}
@@ -206,14 +279,45 @@ func displayShortcutsInColumns(allShortcuts []Shortcut) {
 		columns[currentCol] = append(columns[currentCol], blockLines...)
 	}
 
+	colors := colorsEnabled()
+	styleCodes := map[string]string{
+		"category":    theme.Category,
+		"key":         theme.Key,
+		"description": theme.Description,
+		"separator":   theme.Separator,
+	}
+
+	leftBorder, rightBorder := "", ""
+	if border {
+		leftBorder = colorize("│", theme.Border, colors)
+		rightBorder = colorize("│", theme.Border, colors)
+		top := colorize("┌"+strings.Repeat("─", columnWidth)+"┐", theme.Border, colors)
+		bottom := colorize("└"+strings.Repeat("─", columnWidth)+"┘", theme.Border, colors)
+		for col := 0; col < numDisplayColumns; col++ {
+			fmt.Print(top)
+			fmt.Print(strings.Repeat(" ", columnSpacing))
+		}
+		fmt.Println()
+		defer func() {
+			for col := 0; col < numDisplayColumns; col++ {
+				fmt.Print(bottom)
+				fmt.Print(strings.Repeat(" ", columnSpacing))
+			}
+			fmt.Println()
+		}()
+	}
+
 	// Print the columns
 	for row := 0; row < columnHeight; row++ {
 		for col := 0; col < numDisplayColumns; col++ {
-			line := ""
+			var line styledLine
 			if row < len(columns[col]) {
 				line = columns[col][row]
 			}
-			fmt.Print(formatCell(line, columnWidth))
+			cell := formatCell(line.text, columnWidth)
+			fmt.Print(leftBorder)
+			fmt.Print(colorize(cell, styleCodes[line.kind], colors))
+			fmt.Print(rightBorder)
 			fmt.Print(strings.Repeat(" ", columnSpacing))
 		}
 		fmt.Println()
@@ -221,12 +325,78 @@ func displayShortcutsInColumns(allShortcuts []Shortcut) {
 }
 
 func main() {
-	fmt.Println()
-	shortcuts := getStaticShortcuts()
+	interactive := flag.Bool("i", false, "launch an interactive fuzzy-search TUI")
+	send := flag.Bool("send", false, "with -i, dispatch the selected shortcut via tmux send-keys instead of printing it")
+	static := flag.Bool("static", false, "use the built-in shortcut table instead of the running tmux configuration")
+	format := flag.String("format", "columns", "output format: columns, json, markdown, man, bash, zsh, fish")
+	watch := flag.Bool("watch", false, "keep running and re-render whenever the terminal is resized")
+	themeName := flag.String("theme", "dark", "color theme: dark, light, or mono")
+	themeFile := flag.String("theme-file", "", "path to a custom theme JSON file, overriding --theme")
+	border := flag.Bool("border", false, "draw a box around each column")
+	flag.Parse()
+
+	theme, err := loadTheme(*themeName, *themeFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "tmux-shortcuts:", err)
+		os.Exit(1)
+	}
+
+	shortcuts := loadShortcuts(*static)
+
+	if *interactive {
+		if err := runInteractive(shortcuts, *send); err != nil {
+			fmt.Fprintln(os.Stderr, "tmux-shortcuts:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	for i := range shortcuts {
 		shortcuts[i].Key = strings.Replace(shortcuts[i].Key, "Prefix", "ctrl+b", -1)
 	}
 
-	displayShortcutsInColumns(shortcuts)
+	if *format != "columns" {
+		if err := printFormatted(*format, shortcuts); err != nil {
+			fmt.Fprintln(os.Stderr, "tmux-shortcuts:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *watch {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		runWatch(ctx, shortcuts, theme, *border)
+		return
+	}
+
 	fmt.Println()
+	displayShortcutsInColumns(shortcuts, theme, *border)
+	fmt.Println()
+}
+
+// printFormatted renders shortcuts in the named non-default format and
+// writes the result to stdout.
+func printFormatted(format string, shortcuts []Shortcut) error {
+	switch format {
+	case "json":
+		out, err := renderJSON(shortcuts)
+		if err != nil {
+			return err
+		}
+		fmt.Println(out)
+	case "markdown":
+		fmt.Print(renderMarkdown(shortcuts))
+	case "man":
+		fmt.Print(renderMan(shortcuts))
+	case "bash", "zsh", "fish":
+		out, err := renderCompletion(format)
+		if err != nil {
+			return err
+		}
+		fmt.Print(out)
+	default:
+		return fmt.Errorf("unknown format %q (want columns, json, markdown, man, bash, zsh, or fish)", format)
+	}
+	return nil
 }
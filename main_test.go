@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/mattn/go-runewidth"
+)
+
+func TestCenterWidth(t *testing.T) {
+	cases := []struct {
+		name  string
+		s     string
+		width int
+		want  int
+	}{
+		{"ascii", "Sessions", 20, 20},
+		{"cjk", "会话管理", 20, 20},
+		{"emoji", "🎉 party", 20, 20},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := center(c.s, c.width)
+			if w := runewidth.StringWidth(got); w != c.want {
+				t.Errorf("center(%q, %d) has display width %d, want %d", c.s, c.width, w, c.want)
+			}
+		})
+	}
+}
+
+func TestFormatCellWidth(t *testing.T) {
+	cases := []string{"Prefix d", "日本語テスト", "✨ sparkles", "café"}
+	for _, s := range cases {
+		got := formatCell(s, 25)
+		if w := runewidth.StringWidth(got); w != 25 {
+			t.Errorf("formatCell(%q, 25) has display width %d, want 25", s, w)
+		}
+	}
+}
+
+func TestWordWrapMixedWidth(t *testing.T) {
+	lines := wordWrap("日本語 テスト 文字列 with ascii words mixed in", 10)
+	for _, line := range lines {
+		if w := runewidth.StringWidth(line); w > 10 {
+			t.Errorf("wordWrap line %q has display width %d, want <= 10", line, w)
+		}
+	}
+}
+
+func TestWordWrapHardBreaksOversizedWord(t *testing.T) {
+	lines := wordWrap("一二三四五六七八九十", 4)
+	if len(lines) == 0 {
+		t.Fatal("expected at least one line")
+	}
+	for _, line := range lines {
+		if w := runewidth.StringWidth(line); w > 4 {
+			t.Errorf("wordWrap line %q has display width %d, want <= 4", line, w)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ColorTheme defines the SGR color codes used to style each part of the
+// column layout. Codes are the bare SGR parameters (e.g. "1;36"), without
+// the leading "\x1b[" escape or trailing "m" — colorize adds those.
+type ColorTheme struct {
+	Category    string `json:"category"`
+	Key         string `json:"key"`
+	Description string `json:"description"`
+	Separator   string `json:"separator"`
+	Border      string `json:"border"`
+}
+
+var darkTheme = ColorTheme{
+	Category:    "1;36", // bold cyan
+	Key:         "1;33", // bold yellow
+	Description: "37",   // white
+	Separator:   "90",   // bright black
+	Border:      "90",   // bright black
+}
+
+var lightTheme = ColorTheme{
+	Category:    "1;34", // bold blue
+	Key:         "1;35", // bold magenta
+	Description: "30",   // black
+	Separator:   "37",   // white
+	Border:      "37",   // white
+}
+
+var monoTheme = ColorTheme{}
+
+// resetSGR ends any SGR styling started by colorize.
+const resetSGR = "\x1b[0m"
+
+// loadTheme resolves the ColorTheme to render with: themeFile takes
+// precedence over the named theme if set.
+func loadTheme(name, themeFile string) (ColorTheme, error) {
+	if themeFile != "" {
+		data, err := os.ReadFile(themeFile)
+		if err != nil {
+			return ColorTheme{}, fmt.Errorf("read theme file: %w", err)
+		}
+		var theme ColorTheme
+		if err := json.Unmarshal(data, &theme); err != nil {
+			return ColorTheme{}, fmt.Errorf("parse theme file: %w", err)
+		}
+		return theme, nil
+	}
+
+	switch name {
+	case "", "dark":
+		return darkTheme, nil
+	case "light":
+		return lightTheme, nil
+	case "mono":
+		return monoTheme, nil
+	default:
+		return ColorTheme{}, fmt.Errorf("unknown theme %q (want dark, light, or mono)", name)
+	}
+}
+
+// colorsEnabled reports whether output should be colorized: NO_COLOR and
+// non-TTY stdout both disable it.
+func colorsEnabled() bool {
+	if _, set := os.LookupEnv("NO_COLOR"); set {
+		return false
+	}
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// colorize wraps s in the SGR escape sequence for code, unless code is
+// empty (mono theme) or colors are disabled.
+func colorize(s, code string, enabled bool) string {
+	if !enabled || code == "" {
+		return s
+	}
+	return "\x1b[" + code + "m" + s + resetSGR
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// cacheTTL is how long a cached parse of `tmux list-keys -a` is trusted
+// before getDynamicShortcuts shells out again.
+const cacheTTL = 5 * time.Second
+
+// listKeysLine matches a single line of `tmux list-keys -a` output, e.g.
+// `bind-key -T prefix c new-window` or `bind-key -T copy-mode-vi v send-keys -X begin-selection`.
+var listKeysLine = regexp.MustCompile(`^bind-key\s+(?:-r\s+)?-T\s+(\S+)\s+(\S+)\s+(.+)$`)
+
+// getDynamicShortcuts shells out to the running tmux server to build the
+// shortcut list from the user's actual configuration, rather than the
+// hardcoded table in getStaticShortcuts. The parsed result is cached in
+// $XDG_CACHE_HOME/tmux-shortcuts.json; a cache no older than cacheTTL is
+// reused instead of re-invoking tmux, e.g. across successive --watch
+// redraws.
+func getDynamicShortcuts() ([]Shortcut, error) {
+	if cached, ok := readShortcutCache(); ok {
+		return cached, nil
+	}
+
+	out, err := exec.Command("tmux", "list-keys", "-a").Output()
+	if err != nil {
+		return nil, fmt.Errorf("tmux list-keys: %w", err)
+	}
+
+	prefixLabel, err := getPrefixLabel()
+	if err != nil {
+		prefixLabel = "ctrl+b"
+	}
+
+	var shortcuts []Shortcut
+	for _, line := range strings.Split(string(out), "\n") {
+		m := listKeysLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		table, key, command := m[1], m[2], strings.TrimSpace(m[3])
+
+		displayKey := key
+		if table == "prefix" {
+			displayKey = prefixLabel + " " + key
+		}
+
+		shortcuts = append(shortcuts, Shortcut{
+			Key:         displayKey,
+			Description: command,
+			Category:    categorizeBinding(table, command),
+		})
+	}
+
+	writeShortcutCache(shortcuts)
+
+	return shortcuts, nil
+}
+
+// categorizeBinding infers a display category from the key table a
+// binding lives in and, for prefix-table bindings, the tmux command name.
+func categorizeBinding(table, command string) string {
+	switch table {
+	case "copy-mode", "copy-mode-vi":
+		return "Copy Mode"
+	case "root":
+		return "Root"
+	}
+
+	name := command
+	if i := strings.IndexByte(command, ' '); i >= 0 {
+		name = command[:i]
+	}
+
+	switch name {
+	case "new-window", "rename-window", "next-window", "previous-window",
+		"select-window", "kill-window", "last-window", "find-window",
+		"move-window", "list-windows":
+		return "Windows"
+	case "split-window", "select-pane", "last-pane", "display-panes":
+		return "Pane Splitting & Nav"
+	case "kill-pane", "swap-pane", "resize-pane", "break-pane", "select-layout":
+		return "Pane Management"
+	case "detach-client", "list-sessions", "rename-session", "switch-client",
+		"new-session", "kill-session":
+		return "Sessions"
+	default:
+		return "Misc"
+	}
+}
+
+// getPrefixLabel queries the global prefix key and renders it the way the
+// tool already displays keys elsewhere, e.g. tmux's "C-b" becomes "ctrl+b".
+func getPrefixLabel() (string, error) {
+	out, err := exec.Command("tmux", "show-options", "-g", "prefix").Output()
+	if err != nil {
+		return "", fmt.Errorf("tmux show-options: %w", err)
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) != 2 {
+		return "", fmt.Errorf("unexpected show-options output: %q", out)
+	}
+	return tmuxNotationToDisplay(fields[1]), nil
+}
+
+// tmuxNotationToDisplay renders a tmux key-notation string (e.g. "C-b",
+// "M-x") the way this tool displays keys elsewhere ("ctrl+b", "alt+x").
+func tmuxNotationToDisplay(key string) string {
+	switch {
+	case strings.HasPrefix(key, "C-"):
+		return "ctrl+" + key[2:]
+	case strings.HasPrefix(key, "M-"):
+		return "alt+" + key[2:]
+	default:
+		return key
+	}
+}
+
+// shortcutCachePath returns the path of the dynamic-shortcut cache file,
+// honoring $XDG_CACHE_HOME with the XDG-default fallback of ~/.cache.
+func shortcutCachePath() (string, error) {
+	dir := os.Getenv("XDG_CACHE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(dir, "tmux-shortcuts.json"), nil
+}
+
+// readShortcutCache returns the cached shortcuts if the cache file exists,
+// parses, and is no older than cacheTTL. Any failure is treated as a cache
+// miss so the caller falls back to querying tmux directly.
+func readShortcutCache() ([]Shortcut, bool) {
+	path, err := shortcutCachePath()
+	if err != nil {
+		return nil, false
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > cacheTTL {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var shortcuts []Shortcut
+	if err := json.Unmarshal(data, &shortcuts); err != nil {
+		return nil, false
+	}
+	return shortcuts, true
+}
+
+// writeShortcutCache best-effort writes shortcuts to the cache file for
+// reuse by the next invocation (and for other tools to consume). Failures
+// are silently ignored since the cache is not required for this process.
+func writeShortcutCache(shortcuts []Shortcut) {
+	path, err := shortcutCachePath()
+	if err != nil {
+		return
+	}
+
+	data, err := json.MarshalIndent(shortcuts, "", "  ")
+	if err != nil {
+		return
+	}
+
+	_ = os.MkdirAll(filepath.Dir(path), 0o755)
+	_ = os.WriteFile(path, data, 0o644)
+}
+
+// loadShortcuts returns the shortcut list to display, preferring the live
+// tmux configuration unless useStatic is set or tmux is unreachable (e.g.
+// the tool isn't running inside a tmux session).
+func loadShortcuts(useStatic bool) []Shortcut {
+	if useStatic {
+		return getStaticShortcuts()
+	}
+
+	shortcuts, err := getDynamicShortcuts()
+	if err != nil {
+		return getStaticShortcuts()
+	}
+	return shortcuts
+}
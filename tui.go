@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// match pairs a Shortcut with its fuzzy-search score against the current query.
+type match struct {
+	shortcut Shortcut
+	score    int
+}
+
+// runInteractive launches a full-screen TUI listing all shortcuts with a
+// fuzzy-search filter box at the top. Pressing Enter on the highlighted
+// entry prints its raw tmux command; with send it is dispatched directly
+// to the current tmux session via `tmux send-keys` instead.
+func runInteractive(shortcuts []Shortcut, send bool) error {
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return fmt.Errorf("init screen: %w", err)
+	}
+	if err := screen.Init(); err != nil {
+		return fmt.Errorf("init screen: %w", err)
+	}
+	defer screen.Fini()
+
+	query := ""
+	selected := 0
+
+	for {
+		matches := filterShortcuts(shortcuts, query)
+		if selected >= len(matches) {
+			selected = len(matches) - 1
+		}
+		if selected < 0 {
+			selected = 0
+		}
+
+		drawInteractive(screen, query, matches, selected)
+
+		ev := screen.PollEvent()
+		switch ev := ev.(type) {
+		case *tcell.EventResize:
+			screen.Sync()
+		case *tcell.EventKey:
+			switch {
+			case ev.Key() == tcell.KeyEscape || ev.Key() == tcell.KeyCtrlC:
+				return nil
+			case ev.Key() == tcell.KeyEnter:
+				if len(matches) == 0 {
+					continue
+				}
+				chosen := matches[selected].shortcut
+				screen.Fini()
+				return selectShortcut(chosen, send)
+			case ev.Key() == tcell.KeyUp || ev.Key() == tcell.KeyCtrlP:
+				if selected > 0 {
+					selected--
+				}
+			case ev.Key() == tcell.KeyDown || ev.Key() == tcell.KeyCtrlN:
+				if selected < len(matches)-1 {
+					selected++
+				}
+			case ev.Key() == tcell.KeyBackspace || ev.Key() == tcell.KeyBackspace2:
+				if len(query) > 0 {
+					query = query[:len(query)-1]
+					selected = 0
+				}
+			case ev.Key() == tcell.KeyRune:
+				query += string(ev.Rune())
+				selected = 0
+			}
+		}
+	}
+}
+
+// filterShortcuts scores every shortcut against query and returns the
+// matches sorted best-first. An empty query matches everything in its
+// original order.
+func filterShortcuts(shortcuts []Shortcut, query string) []match {
+	matches := make([]match, 0, len(shortcuts))
+	for _, s := range shortcuts {
+		haystack := s.Key + " " + s.Description + " " + s.Category
+		score, ok := fuzzyMatch(query, haystack)
+		if !ok {
+			continue
+		}
+		matches = append(matches, match{shortcut: s, score: score})
+	}
+	if query != "" {
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].score > matches[j].score
+		})
+	}
+	return matches
+}
+
+func drawInteractive(screen tcell.Screen, query string, matches []match, selected int) {
+	screen.Clear()
+	width, height := screen.Size()
+
+	promptStyle := tcell.StyleDefault.Bold(true)
+	drawText(screen, 0, 0, promptStyle, "> "+query)
+
+	selStyle := tcell.StyleDefault.Reverse(true)
+	plainStyle := tcell.StyleDefault
+
+	for row := 1; row < height && row-1 < len(matches); row++ {
+		m := matches[row-1]
+		line := fmt.Sprintf("%-20s %-10s %s", m.shortcut.Key, m.shortcut.Category, m.shortcut.Description)
+		if len(line) > width {
+			line = line[:width]
+		}
+		style := plainStyle
+		if row-1 == selected {
+			style = selStyle
+		}
+		drawText(screen, 0, row, style, line)
+	}
+
+	screen.ShowCursor(2+len([]rune(query)), 0)
+	screen.Show()
+}
+
+func drawText(screen tcell.Screen, x, y int, style tcell.Style, text string) {
+	for i, r := range []rune(text) {
+		screen.SetContent(x+i, y, r, nil, style)
+	}
+}
+
+// selectShortcut either prints the raw tmux command for shortcut or,
+// when send is true, dispatches it to the current tmux session.
+func selectShortcut(shortcut Shortcut, send bool) error {
+	keys := tmuxKeySequence(shortcut.Key)
+	if !send {
+		fmt.Println(keys)
+		return nil
+	}
+	return sendTmuxKeys(keys)
+}
+
+// tmuxKeySequence converts a displayed key into the key sequence
+// tmux send-keys expects. It handles both the static table's "Prefix d"
+// form and the dynamic loader's already-humanized "ctrl+b c" / "alt+x"
+// form (tmux.go's tmuxNotationToDisplay), normalizing either back to
+// tmux key notation (e.g. "C-b d", "C-b c", "M-x").
+func tmuxKeySequence(key string) string {
+	key = strings.Replace(key, "Prefix", "C-b", 1)
+	key = strings.Replace(key, "ctrl+", "C-", 1)
+	key = strings.Replace(key, "alt+", "M-", 1)
+	return key
+}
+
+// sendTmuxKeys dispatches keys to the current tmux session via
+// `tmux send-keys`.
+func sendTmuxKeys(keys string) error {
+	args := append([]string{"send-keys"}, strings.Fields(keys)...)
+	cmd := exec.Command("tmux", args...)
+	return cmd.Run()
+}
@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestTmuxKeySequence(t *testing.T) {
+	cases := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{"static prefix binding", "Prefix d", "C-b d"},
+		{"dynamic prefix binding", "ctrl+b c", "C-b c"},
+		{"dynamic custom prefix", "ctrl+a c", "C-a c"},
+		{"dynamic alt binding", "alt+Left", "M-Left"},
+		{"bare non-prefix binding", "v", "v"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := tmuxKeySequence(c.key); got != c.want {
+				t.Errorf("tmuxKeySequence(%q) = %q, want %q", c.key, got, c.want)
+			}
+		})
+	}
+}
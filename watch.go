@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// clearScreen is the ANSI sequence to clear the terminal and move the
+// cursor to the top-left corner.
+const clearScreen = "\x1b[2J\x1b[H"
+
+// runWatch keeps the process alive, clearing the screen and re-rendering
+// shortcuts whenever the terminal is resized (SIGWINCH). It returns when
+// ctx is canceled, e.g. on Ctrl-C, making it suitable for a persistent
+// tmux popup pane (`tmux display-popup -E tmux-shortcuts --watch`).
+func runWatch(ctx context.Context, shortcuts []Shortcut, theme ColorTheme, border bool) {
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+	defer signal.Stop(winch)
+
+	renderWatch(shortcuts, theme, border)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-winch:
+			renderWatch(shortcuts, theme, border)
+		}
+	}
+}
+
+func renderWatch(shortcuts []Shortcut, theme ColorTheme, border bool) {
+	fmt.Print(clearScreen)
+	fmt.Println()
+	displayShortcutsInColumns(shortcuts, theme, border)
+	fmt.Println()
+}